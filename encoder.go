@@ -0,0 +1,241 @@
+package uuid
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync/atomic"
+
+	guuid "github.com/google/uuid"
+	"github.com/lithammer/shortuuid/v4"
+)
+
+// Encoder converts between a UUID and its short string representation.
+// Implementations must be safe for concurrent use.
+type Encoder interface {
+	Encode(u guuid.UUID) string
+	Decode(s string) (guuid.UUID, error)
+}
+
+// shortuuidEncoder adapts a shortuuid.Encoder to the Encoder interface.
+type shortuuidEncoder struct {
+	enc shortuuid.Encoder
+}
+
+func (s shortuuidEncoder) Encode(u guuid.UUID) string {
+	return s.enc.Encode(u)
+}
+
+func (s shortuuidEncoder) Decode(str string) (guuid.UUID, error) {
+	return s.enc.Decode(str)
+}
+
+// crockfordBase32Encoder implements Encoder using the sortable Crockford
+// base32 scheme also used by SortableShortString.
+type crockfordBase32Encoder struct{}
+
+func (crockfordBase32Encoder) Encode(u guuid.UUID) string {
+	return encodeCrockford32(u[:])
+}
+
+func (crockfordBase32Encoder) Decode(s string) (guuid.UUID, error) {
+	b, err := decodeCrockford32(s)
+	if err != nil {
+		return guuid.UUID{}, err
+	}
+	return guuid.FromBytes(b)
+}
+
+// bigAlphabetEncoder implements Encoder by treating the UUID as a 128-bit
+// unsigned integer and converting it to a fixed-width representation in an
+// arbitrary alphabet, the same approach shortuuid uses for its default
+// base57 alphabet.
+type bigAlphabetEncoder struct {
+	alphabet []rune
+	base     *big.Int
+	width    int
+}
+
+// NewEncoderWithAlphabet builds an Encoder that represents UUIDs in the
+// given alphabet. The alphabet must contain at least 2 and at most 255
+// distinct characters. The resulting strings are zero-padded to a fixed
+// width so that two different UUIDs never decode to encodings of different
+// lengths.
+func NewEncoderWithAlphabet(alphabet string) (Encoder, error) {
+	runes := []rune(alphabet)
+	seen := make(map[rune]struct{}, len(runes))
+	for _, r := range runes {
+		if _, dup := seen[r]; dup {
+			return nil, fmt.Errorf("alphabet contains duplicate character %q", r)
+		}
+		seen[r] = struct{}{}
+	}
+	if len(runes) < 2 || len(runes) > 255 {
+		return nil, fmt.Errorf("alphabet must have between 2 and 255 distinct characters, got %d", len(runes))
+	}
+
+	base := big.NewInt(int64(len(runes)))
+	width := 0
+	for max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1)); max.Sign() > 0; width++ {
+		max.Div(max, base)
+	}
+
+	return bigAlphabetEncoder{alphabet: runes, base: base, width: width}, nil
+}
+
+func (e bigAlphabetEncoder) Encode(u guuid.UUID) string {
+	n := new(big.Int).SetBytes(u[:])
+	digits := make([]rune, e.width)
+	zero := e.alphabet[0]
+	for i := range digits {
+		digits[i] = zero
+	}
+
+	mod := new(big.Int)
+	for i := e.width - 1; i >= 0 && n.Sign() > 0; i-- {
+		n.DivMod(n, e.base, mod)
+		digits[i] = e.alphabet[mod.Int64()]
+	}
+	return string(digits)
+}
+
+func (e bigAlphabetEncoder) Decode(s string) (guuid.UUID, error) {
+	chars := []rune(s)
+	if len(chars) != e.width {
+		return guuid.UUID{}, fmt.Errorf("invalid encoded length: got %d, want %d", len(chars), e.width)
+	}
+
+	n := new(big.Int)
+	for _, c := range chars {
+		idx := slices.Index(e.alphabet, c)
+		if idx < 0 {
+			return guuid.UUID{}, fmt.Errorf("invalid character %q for alphabet", c)
+		}
+		n.Mul(n, e.base)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	b := n.Bytes()
+	if len(b) > 16 {
+		return guuid.UUID{}, fmt.Errorf("decoded value overflows a UUID")
+	}
+	var buf [16]byte
+	copy(buf[16-len(b):], b)
+	return guuid.UUID(buf), nil
+}
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var (
+	// Base57Encoder is shortuuid's default alphabet, unchanged for backward
+	// compatibility with existing encoded values.
+	Base57Encoder Encoder = shortuuidEncoder{enc: shortuuid.DefaultEncoder}
+
+	// Base58Encoder uses the Bitcoin base58 alphabet.
+	Base58Encoder Encoder = mustEncoder(base58Alphabet)
+
+	// Base62Encoder uses the alphanumeric base62 alphabet.
+	Base62Encoder Encoder = mustEncoder(base62Alphabet)
+
+	// CrockfordBase32Encoder uses Crockford's base32 alphabet. Its encoding
+	// sorts lexicographically in the same order as the underlying UUID
+	// bytes; see SortableShortString.
+	CrockfordBase32Encoder Encoder = crockfordBase32Encoder{}
+)
+
+func mustEncoder(alphabet string) Encoder {
+	enc, err := NewEncoderWithAlphabet(alphabet)
+	if err != nil {
+		panic(fmt.Sprintf("uuid: invalid built-in alphabet %q: %v", alphabet, err))
+	}
+	return enc
+}
+
+// encoderBox lets defaultEncoder be stored in an atomic.Value: the
+// interface values built-in and custom Encoder implementations produce have
+// different concrete types, and atomic.Value.Store panics if the concrete
+// type changes between calls. Boxing in a fixed-type struct sidesteps that.
+type encoderBox struct {
+	enc Encoder
+}
+
+// defaultEncoder holds the package-wide Encoder used by Parse, ShortString,
+// and JSON marshaling. It starts out as Base57Encoder for backward
+// compatibility with existing shortuuid-encoded values.
+var defaultEncoder atomic.Value
+
+func init() {
+	defaultEncoder.Store(encoderBox{enc: Base57Encoder})
+}
+
+// SetDefaultEncoder changes the Encoder used by Parse, ShortString, and JSON
+// marshaling for the lifetime of the process. Existing encoded values remain
+// valid only if decoded with the encoder that produced them.
+func SetDefaultEncoder(enc Encoder) {
+	defaultEncoder.Store(encoderBox{enc: enc})
+}
+
+// DefaultEncoder returns the Encoder currently used by Parse, ShortString,
+// and JSON marshaling.
+func DefaultEncoder() Encoder {
+	return defaultEncoder.Load().(encoderBox).enc
+}
+
+// ShortStringWith returns the short string representation of the ID using
+// the given Encoder instead of the package's default.
+func (id ID) ShortStringWith(enc Encoder) string {
+	return enc.Encode(id.UUID)
+}
+
+// ParseWith parses a short string produced by the given Encoder into an ID.
+func ParseWith(enc Encoder, s string) (ID, error) {
+	u, err := enc.Decode(s)
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to decode shortuuid: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// ctxEncoderKey is the context.Context key under which WithEncoder stores an
+// Encoder.
+type ctxEncoderKey struct{}
+
+// WithEncoder returns a copy of ctx carrying enc, for use with ContextEncoder
+// or EncoderFromContext.
+func WithEncoder(ctx context.Context, enc Encoder) context.Context {
+	return context.WithValue(ctx, ctxEncoderKey{}, enc)
+}
+
+// EncoderFromContext returns the Encoder stored in ctx by WithEncoder, or
+// DefaultEncoder if none was stored.
+func EncoderFromContext(ctx context.Context) Encoder {
+	if enc, ok := ctx.Value(ctxEncoderKey{}).(Encoder); ok {
+		return enc
+	}
+	return DefaultEncoder()
+}
+
+// ContextEncoder is an Encoder that defers to whatever Encoder is stored in
+// a context.Context, falling back to DefaultEncoder. It lets a
+// request-scoped tenant use its own alphabet without the caller having to
+// plumb an Encoder value through every function signature.
+type ContextEncoder struct {
+	ctx context.Context
+}
+
+// NewContextEncoder builds a ContextEncoder bound to ctx.
+func NewContextEncoder(ctx context.Context) ContextEncoder {
+	return ContextEncoder{ctx: ctx}
+}
+
+func (c ContextEncoder) Encode(u guuid.UUID) string {
+	return EncoderFromContext(c.ctx).Encode(u)
+}
+
+func (c ContextEncoder) Decode(s string) (guuid.UUID, error) {
+	return EncoderFromContext(c.ctx).Decode(s)
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+	"context"
+	"testing"
+
+	guuid "github.com/google/uuid"
+)
+
+func TestBuiltinEncodersRoundTrip(t *testing.T) {
+	encoders := map[string]Encoder{
+		"base57":    Base57Encoder,
+		"base58":    Base58Encoder,
+		"base62":    Base62Encoder,
+		"crockford": CrockfordBase32Encoder,
+	}
+
+	u := guuid.New()
+	for name, enc := range encoders {
+		t.Run(name, func(t *testing.T) {
+			s := enc.Encode(u)
+			decoded, err := enc.Decode(s)
+			if err != nil {
+				t.Fatalf("Decode failed: %v", err)
+			}
+			if decoded != u {
+				t.Errorf("round-trip mismatch: got %v, want %v", decoded, u)
+			}
+		})
+	}
+}
+
+func TestBuiltinEncodersFixedWidth(t *testing.T) {
+	encoders := []Encoder{Base57Encoder, Base58Encoder, Base62Encoder, CrockfordBase32Encoder}
+
+	for _, enc := range encoders {
+		a := enc.Encode(guuid.Nil)
+		var max guuid.UUID
+		for i := range max {
+			max[i] = 0xff
+		}
+		b := enc.Encode(max)
+		if len(a) != len(b) {
+			t.Errorf("expected fixed-width encoding, got %d and %d", len(a), len(b))
+		}
+	}
+}
+
+func TestNewEncoderWithAlphabetRejectsDuplicates(t *testing.T) {
+	if _, err := NewEncoderWithAlphabet("aab"); err == nil {
+		t.Error("expected error for duplicate characters")
+	}
+}
+
+func TestNewEncoderWithAlphabetRejectsTooSmall(t *testing.T) {
+	if _, err := NewEncoderWithAlphabet("a"); err == nil {
+		t.Error("expected error for single-character alphabet")
+	}
+}
+
+func TestSetDefaultEncoder(t *testing.T) {
+	original := DefaultEncoder()
+	defer SetDefaultEncoder(original)
+
+	SetDefaultEncoder(Base62Encoder)
+
+	id := New()
+	s := id.ShortString()
+
+	parsed, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if !id.Equal(parsed) {
+		t.Error("Parse should decode using the new default encoder")
+	}
+}
+
+func TestShortStringWithAndParseWith(t *testing.T) {
+	id := New()
+	s := id.ShortStringWith(CrockfordBase32Encoder)
+
+	parsed, err := ParseWith(CrockfordBase32Encoder, s)
+	if err != nil {
+		t.Fatalf("ParseWith failed: %v", err)
+	}
+	if !id.Equal(parsed) {
+		t.Error("ParseWith should recover original ID")
+	}
+}
+
+func TestContextEncoder(t *testing.T) {
+	id := New()
+
+	ctx := WithEncoder(context.Background(), Base62Encoder)
+	enc := NewContextEncoder(ctx)
+
+	s := enc.Encode(id.UUID)
+	decoded, err := enc.Decode(s)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if decoded != id.UUID {
+		t.Error("ContextEncoder should use the encoder stored in its context")
+	}
+
+	fallback := NewContextEncoder(context.Background())
+	if fallback.Encode(id.UUID) != DefaultEncoder().Encode(id.UUID) {
+		t.Error("ContextEncoder should fall back to DefaultEncoder when none is in context")
+	}
+}
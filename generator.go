@@ -0,0 +1,128 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	guuid "github.com/google/uuid"
+)
+
+// NewBatch returns n freshly-generated random IDs. If a global Generator has
+// been installed with SetGlobal, the batch is drawn from its pool;
+// otherwise each ID is generated directly, the same as calling New() n
+// times.
+func NewBatch(n int) []ID {
+	ids := make([]ID, n)
+	for i := range ids {
+		ids[i] = New()
+	}
+	return ids
+}
+
+// Generator pre-fills a buffered channel of random IDs in a background
+// goroutine so that hot paths, such as request handlers and bulk inserts,
+// don't pay crypto/rand's cost on every call to New.
+type Generator struct {
+	pool   chan ID
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	lastMS  int64
+	counter uint16
+}
+
+// NewGenerator creates a Generator whose background goroutine keeps up to
+// bufferSize pre-generated random IDs ready for New.
+func NewGenerator(bufferSize int) *Generator {
+	g := &Generator{
+		pool:   make(chan ID, bufferSize),
+		stopCh: make(chan struct{}),
+	}
+	g.wg.Add(1)
+	go g.fill()
+	return g
+}
+
+func (g *Generator) fill() {
+	defer g.wg.Done()
+	for {
+		select {
+		case <-g.stopCh:
+			return
+		case g.pool <- ID{UUID: guuid.New()}:
+		}
+	}
+}
+
+// New returns a pre-generated random ID from the pool.
+func (g *Generator) New() ID {
+	return <-g.pool
+}
+
+// NewV7 returns a time-ordered v7 ID. It keeps a 12-bit counter in the
+// sub-millisecond field (the same field v7 reserves for this purpose) so
+// that, within a single millisecond, successive calls on the same Generator
+// always produce strictly increasing IDs even under concurrent access. If
+// the counter overflows 4095 entries within one millisecond, the timestamp
+// is advanced instead of wrapping, so ordering is never violated.
+func (g *Generator) NewV7() (ID, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := time.Now().UnixMilli()
+	if ms <= g.lastMS {
+		ms = g.lastMS
+		g.counter++
+		if g.counter > 0x0fff {
+			ms++
+			g.counter = 0
+		}
+	} else {
+		g.counter = 0
+	}
+	g.lastMS = ms
+
+	var buf [16]byte
+	buf[0] = byte(ms >> 40)
+	buf[1] = byte(ms >> 32)
+	buf[2] = byte(ms >> 24)
+	buf[3] = byte(ms >> 16)
+	buf[4] = byte(ms >> 8)
+	buf[5] = byte(ms)
+
+	buf[6] = 0x70 | byte(g.counter>>8) // version 7, top 4 bits of counter
+	buf[7] = byte(g.counter)
+
+	if _, err := rand.Read(buf[8:]); err != nil {
+		return ID{}, fmt.Errorf("failed to generate random bits: %w", err)
+	}
+	buf[8] = (buf[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	u, err := guuid.FromBytes(buf[:])
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to build v7 uuid: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// Stop shuts down the Generator's background goroutine and waits for it to
+// exit. IDs still buffered in the pool are discarded. A stopped Generator
+// must not be used again.
+func (g *Generator) Stop() {
+	close(g.stopCh)
+	g.wg.Wait()
+}
+
+// globalGenerator is the Generator, if any, that New transparently draws
+// from. See SetGlobal.
+var globalGenerator atomic.Pointer[Generator]
+
+// SetGlobal installs g as the package-wide Generator used by New. Pass nil
+// to revert to generating IDs directly.
+func SetGlobal(g *Generator) {
+	globalGenerator.Store(g)
+}
@@ -0,0 +1,121 @@
+package uuid
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+func TestNewBatch(t *testing.T) {
+	ids := NewBatch(10)
+	if len(ids) != 10 {
+		t.Fatalf("expected 10 IDs, got %d", len(ids))
+	}
+
+	seen := make(map[ID]bool, len(ids))
+	for _, id := range ids {
+		if id.IsZero() {
+			t.Error("batch should not contain zero IDs")
+		}
+		if seen[id] {
+			t.Error("batch should not contain duplicate IDs")
+		}
+		seen[id] = true
+	}
+}
+
+func TestGeneratorNew(t *testing.T) {
+	g := NewGenerator(4)
+	defer g.Stop()
+
+	id := g.New()
+	if id.IsZero() {
+		t.Error("Generator.New should not return a zero ID")
+	}
+}
+
+func TestGeneratorNewV7Monotonic(t *testing.T) {
+	g := NewGenerator(4)
+	defer g.Stop()
+
+	const n = 2000
+	ids := make([]ID, n)
+	for i := range ids {
+		id, err := g.NewV7()
+		if err != nil {
+			t.Fatalf("NewV7 failed: %v", err)
+		}
+		ids[i] = id
+	}
+
+	for i := 1; i < n; i++ {
+		prev, cur := ids[i-1].UUID, ids[i].UUID
+		less := false
+		for b := 0; b < 16; b++ {
+			if prev[b] != cur[b] {
+				less = prev[b] < cur[b]
+				break
+			}
+		}
+		if !less {
+			t.Fatalf("expected ids[%d] < ids[%d], got %s >= %s", i-1, i, prev, cur)
+		}
+	}
+}
+
+func TestGeneratorNewV7ConcurrentMonotonic(t *testing.T) {
+	g := NewGenerator(4)
+	defer g.Stop()
+
+	const goroutines = 8
+	const perGoroutine = 200
+
+	var mu sync.Mutex
+	ids := make([]ID, 0, goroutines*perGoroutine)
+
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			var prev ID
+			for j := 0; j < perGoroutine; j++ {
+				id, err := g.NewV7()
+				if err != nil {
+					t.Errorf("NewV7 failed: %v", err)
+					return
+				}
+				if j > 0 && bytes.Compare(prev.UUID[:], id.UUID[:]) >= 0 {
+					t.Errorf("out-of-order v7 IDs generated under concurrency: %s >= %s", prev, id)
+					return
+				}
+				prev = id
+				mu.Lock()
+				ids = append(ids, id)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[ID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			t.Fatalf("duplicate v7 ID generated under concurrency: %s", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestSetGlobal(t *testing.T) {
+	g := NewGenerator(4)
+	defer g.Stop()
+	defer SetGlobal(nil)
+
+	SetGlobal(g)
+
+	id := New()
+	if id.IsZero() {
+		t.Error("New should return a non-zero ID when a global Generator is set")
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 
 	guuid "github.com/google/uuid"
-	"github.com/lithammer/shortuuid/v4"
 )
 
 // ID represents a UUID that can be marshaled as a shortuuid for JSON
@@ -15,8 +14,13 @@ type ID struct {
 	guuid.UUID
 }
 
-// New creates a new random ID
+// New creates a new random ID. If a global Generator has been installed
+// with SetGlobal, the ID is drawn from its pre-generated pool instead of
+// calling crypto/rand directly.
 func New() ID {
+	if g := globalGenerator.Load(); g != nil {
+		return g.New()
+	}
 	return ID{UUID: guuid.New()}
 }
 
@@ -25,13 +29,11 @@ func FromUUID(u guuid.UUID) ID {
 	return ID{UUID: u}
 }
 
-// Parse parses a shortuuid string into an ID
+// Parse parses a shortuuid string, as produced by the current default
+// Encoder, into an ID. Use ParseWith to decode a string produced by a
+// non-default Encoder.
 func Parse(s string) (ID, error) {
-	u, err := shortuuid.DefaultEncoder.Decode(s)
-	if err != nil {
-		return ID{}, fmt.Errorf("failed to decode shortuuid: %w", err)
-	}
-	return ID{UUID: u}, nil
+	return ParseWith(DefaultEncoder(), s)
 }
 
 // FromString parses a standard UUID string into an ID
@@ -48,9 +50,11 @@ func (id ID) String() string {
 	return id.UUID.String()
 }
 
-// ShortString returns the shortuuid string representation
+// ShortString returns the shortuuid string representation, using the
+// current default Encoder. Use ShortStringWith to encode with a specific
+// Encoder.
 func (id ID) ShortString() string {
-	return shortuuid.DefaultEncoder.Encode(id.UUID)
+	return id.ShortStringWith(DefaultEncoder())
 }
 
 // MarshalJSON implements json.Marshaler to encode as shortuuid
@@ -74,18 +78,28 @@ func (id *ID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Value implements driver.Valuer for database storage as UUID
+// Value implements driver.Valuer for database storage as UUID. If binary
+// mode has been enabled via SetBinaryMode, it returns the raw 16-byte
+// representation instead, for BINARY(16)/bytea columns.
 func (id ID) Value() (driver.Value, error) {
+	if binaryMode.Load() {
+		b := id.UUID
+		return b[:], nil
+	}
 	return id.UUID.String(), nil
 }
 
-// Scan implements sql.Scanner for database retrieval from UUID
+// Scan implements sql.Scanner for database retrieval from UUID. It accepts
+// the standard UUID string form as well as raw binary representations: a
+// [16]byte, or a []byte that is either the 36-character text form or the raw
+// 16-byte form used by drivers such as pgx, MySQL's BINARY(16), and
+// postgres's bytea.
 func (id *ID) Scan(value interface{}) error {
 	if value == nil {
 		*id = ID{}
 		return nil
 	}
-	
+
 	switch v := value.(type) {
 	case string:
 		u, err := guuid.Parse(v)
@@ -95,12 +109,23 @@ func (id *ID) Scan(value interface{}) error {
 		*id = ID{UUID: u}
 		return nil
 	case []byte:
+		if len(v) == 16 {
+			u, err := guuid.FromBytes(v)
+			if err != nil {
+				return fmt.Errorf("failed to scan UUID bytes: %w", err)
+			}
+			*id = ID{UUID: u}
+			return nil
+		}
 		u, err := guuid.Parse(string(v))
 		if err != nil {
 			return fmt.Errorf("failed to scan UUID bytes: %w", err)
 		}
 		*id = ID{UUID: u}
 		return nil
+	case [16]byte:
+		*id = ID{UUID: guuid.UUID(v)}
+		return nil
 	case guuid.UUID:
 		*id = ID{UUID: v}
 		return nil
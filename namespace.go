@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"sync"
+
+	guuid "github.com/google/uuid"
+)
+
+// Predefined namespaces from RFC 4122 Appendix C, for use with NewV3 and
+// NewV5.
+var (
+	NamespaceDNS  = ID{UUID: guuid.NameSpaceDNS}
+	NamespaceURL  = ID{UUID: guuid.NameSpaceURL}
+	NamespaceOID  = ID{UUID: guuid.NameSpaceOID}
+	NamespaceX500 = ID{UUID: guuid.NameSpaceX500}
+)
+
+// NewV3 creates a deterministic UUID version 3 ID from a namespace and name,
+// using MD5. Given the same namespace and name, it always produces the same
+// ID. Prefer NewV5 for new namespaces; v3 exists for compatibility with
+// systems that require MD5.
+func NewV3(namespace ID, name []byte) ID {
+	return ID{UUID: guuid.NewMD5(namespace.UUID, name)}
+}
+
+// NewV5 creates a deterministic UUID version 5 ID from a namespace and name,
+// using SHA-1. Given the same namespace and name, it always produces the
+// same ID, which makes it suitable for idempotent upserts keyed by a
+// reproducible ID derived from some other natural key.
+func NewV5(namespace ID, name []byte) ID {
+	return ID{UUID: guuid.NewSHA1(namespace.UUID, name)}
+}
+
+var (
+	namespaceMu       sync.RWMutex
+	namespaceRegistry = map[string]ID{}
+)
+
+// RegisterNamespace records id under name so it can later be retrieved with
+// NamespaceByName. Applications can use this to declare stable, well-known
+// namespaces once at startup and derive reproducible IDs for entities (such
+// as users or tenants) from them via NewV5.
+func RegisterNamespace(name string, id ID) {
+	namespaceMu.Lock()
+	defer namespaceMu.Unlock()
+	namespaceRegistry[name] = id
+}
+
+// NamespaceByName returns the namespace ID previously registered under name
+// with RegisterNamespace, and whether one was found.
+func NamespaceByName(name string) (ID, bool) {
+	namespaceMu.RLock()
+	defer namespaceMu.RUnlock()
+	id, ok := namespaceRegistry[name]
+	return id, ok
+}
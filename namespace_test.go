@@ -0,0 +1,59 @@
+package uuid
+
+import "testing"
+
+func TestNewV5Deterministic(t *testing.T) {
+	a := NewV5(NamespaceDNS, []byte("example.com"))
+	b := NewV5(NamespaceDNS, []byte("example.com"))
+
+	if !a.Equal(b) {
+		t.Error("NewV5 should be deterministic for the same namespace and name")
+	}
+	if a.UUID.Version() != 5 {
+		t.Errorf("expected version 5, got %d", a.UUID.Version())
+	}
+}
+
+func TestNewV5DifferentInputs(t *testing.T) {
+	a := NewV5(NamespaceDNS, []byte("example.com"))
+	b := NewV5(NamespaceDNS, []byte("example.org"))
+	c := NewV5(NamespaceURL, []byte("example.com"))
+
+	if a.Equal(b) {
+		t.Error("different names should produce different IDs")
+	}
+	if a.Equal(c) {
+		t.Error("different namespaces should produce different IDs")
+	}
+}
+
+func TestNewV3Deterministic(t *testing.T) {
+	a := NewV3(NamespaceDNS, []byte("example.com"))
+	b := NewV3(NamespaceDNS, []byte("example.com"))
+
+	if !a.Equal(b) {
+		t.Error("NewV3 should be deterministic for the same namespace and name")
+	}
+	if a.UUID.Version() != 3 {
+		t.Errorf("expected version 3, got %d", a.UUID.Version())
+	}
+}
+
+func TestRegisterAndLookupNamespace(t *testing.T) {
+	ns := NewV5(NamespaceDNS, []byte("myapp.internal"))
+	RegisterNamespace("myapp", ns)
+
+	got, ok := NamespaceByName("myapp")
+	if !ok {
+		t.Fatal("expected registered namespace to be found")
+	}
+	if !got.Equal(ns) {
+		t.Error("NamespaceByName should return the registered ID")
+	}
+}
+
+func TestNamespaceByNameMissing(t *testing.T) {
+	if _, ok := NamespaceByName("does-not-exist"); ok {
+		t.Error("expected lookup of unregistered namespace to fail")
+	}
+}
@@ -0,0 +1,97 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sync/atomic"
+
+	guuid "github.com/google/uuid"
+)
+
+// binaryMode controls whether ID.Value returns the UUID's raw 16-byte form
+// instead of its string form. See SetBinaryMode.
+var binaryMode atomic.Bool
+
+// SetBinaryMode switches ID.Value (and NullableID.Value, which delegates to
+// it) to return the raw 16-byte representation of the UUID instead of its
+// string form. Enable this when the target column is a binary type such as
+// Postgres's bytea or MySQL's BINARY(16); leave it disabled for text/uuid
+// columns. Scan already accepts both forms regardless of this setting.
+func SetBinaryMode(enabled bool) {
+	binaryMode.Store(enabled)
+}
+
+// BinaryID is an ID whose Value always returns the raw 16-byte
+// representation, regardless of the package-level binary mode setting. Use
+// it to mix binary and string UUID columns in the same process, for example
+// when only one table uses BINARY(16).
+type BinaryID struct {
+	ID
+}
+
+// NewBinaryID wraps an existing ID as a BinaryID.
+func NewBinaryID(id ID) BinaryID {
+	return BinaryID{ID: id}
+}
+
+// Value implements driver.Valuer, always returning the raw 16 bytes of the
+// UUID.
+func (b BinaryID) Value() (driver.Value, error) {
+	raw := b.ID.UUID
+	return raw[:], nil
+}
+
+// MSSQLUniqueIdentifier wraps an ID to Scan and Value using the same
+// mixed-endian byte layout as go-mssqldb's UniqueIdentifier type. SQL
+// Server's uniqueidentifier stores the first three groups (time_low,
+// time_mid, time_hi_and_version) in little-endian order, so the first 8
+// bytes must be swapped relative to RFC 4122's big-endian wire format.
+type MSSQLUniqueIdentifier struct {
+	ID
+}
+
+// NewMSSQLUniqueIdentifier wraps an existing ID for SQL Server storage.
+func NewMSSQLUniqueIdentifier(id ID) MSSQLUniqueIdentifier {
+	return MSSQLUniqueIdentifier{ID: id}
+}
+
+// Value implements driver.Valuer, byte-swapping into SQL Server's
+// uniqueidentifier layout.
+func (m MSSQLUniqueIdentifier) Value() (driver.Value, error) {
+	raw := m.ID.UUID
+	return mssqlSwap(raw[:]), nil
+}
+
+// Scan implements sql.Scanner, byte-swapping out of SQL Server's
+// uniqueidentifier layout.
+func (m *MSSQLUniqueIdentifier) Scan(value interface{}) error {
+	if value == nil {
+		*m = MSSQLUniqueIdentifier{}
+		return nil
+	}
+
+	v, ok := value.([]byte)
+	if !ok || len(v) != 16 {
+		return fmt.Errorf("cannot scan %T into MSSQLUniqueIdentifier", value)
+	}
+
+	u, err := guuid.FromBytes(mssqlSwap(v))
+	if err != nil {
+		return fmt.Errorf("failed to scan uniqueidentifier bytes: %w", err)
+	}
+	m.ID = ID{UUID: u}
+	return nil
+}
+
+// mssqlSwap reorders the first three groups of a 16-byte UUID between RFC
+// 4122's big-endian layout and SQL Server's little-endian layout. The
+// transformation is its own inverse, so the same function is used for both
+// Value and Scan.
+func mssqlSwap(b []byte) []byte {
+	out := make([]byte, 16)
+	out[0], out[1], out[2], out[3] = b[3], b[2], b[1], b[0]
+	out[4], out[5] = b[5], b[4]
+	out[6], out[7] = b[7], b[6]
+	copy(out[8:], b[8:])
+	return out
+}
@@ -0,0 +1,110 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestScanRawBytes(t *testing.T) {
+	original := New()
+	raw := original.UUID
+
+	var scanned ID
+	if err := scanned.Scan(raw[:]); err != nil {
+		t.Fatalf("Scan(raw bytes) failed: %v", err)
+	}
+	if !original.Equal(scanned) {
+		t.Error("Scan should recover original ID from raw 16-byte slice")
+	}
+}
+
+func TestScanFixedBytes(t *testing.T) {
+	original := New()
+
+	var fixed [16]byte
+	copy(fixed[:], original.UUID[:])
+
+	var scanned ID
+	if err := scanned.Scan(fixed); err != nil {
+		t.Fatalf("Scan([16]byte) failed: %v", err)
+	}
+	if !original.Equal(scanned) {
+		t.Error("Scan should recover original ID from [16]byte")
+	}
+}
+
+func TestBinaryMode(t *testing.T) {
+	SetBinaryMode(true)
+	defer SetBinaryMode(false)
+
+	original := New()
+	value, err := original.Value()
+	if err != nil {
+		t.Fatalf("Value() failed: %v", err)
+	}
+
+	raw, ok := value.([]byte)
+	if !ok || len(raw) != 16 {
+		t.Fatalf("expected 16-byte []byte, got %T", value)
+	}
+
+	var scanned ID
+	if err := scanned.Scan(raw); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if !original.Equal(scanned) {
+		t.Error("binary round-trip should preserve ID")
+	}
+}
+
+func TestBinaryID(t *testing.T) {
+	original := New()
+	bid := NewBinaryID(original)
+
+	value, err := bid.Value()
+	if err != nil {
+		t.Fatalf("Value() failed: %v", err)
+	}
+	raw, ok := value.([]byte)
+	if !ok || len(raw) != 16 {
+		t.Fatalf("expected 16-byte []byte, got %T", value)
+	}
+
+	var scanned ID
+	if err := scanned.Scan(raw); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if !original.Equal(scanned) {
+		t.Error("BinaryID round-trip should preserve ID")
+	}
+}
+
+func TestMSSQLUniqueIdentifierRoundTrip(t *testing.T) {
+	original := New()
+	m := NewMSSQLUniqueIdentifier(original)
+
+	value, err := m.Value()
+	if err != nil {
+		t.Fatalf("Value() failed: %v", err)
+	}
+
+	var scanned MSSQLUniqueIdentifier
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("Scan() failed: %v", err)
+	}
+	if !original.Equal(scanned.ID) {
+		t.Error("MSSQLUniqueIdentifier round-trip should preserve ID")
+	}
+}
+
+func TestMSSQLSwapIsInvolution(t *testing.T) {
+	id := New()
+	raw := id.UUID
+	swapped := mssqlSwap(raw[:])
+	back := mssqlSwap(swapped)
+
+	for i := range raw {
+		if back[i] != raw[i] {
+			t.Fatalf("mssqlSwap should be its own inverse, byte %d: got %x want %x", i, back[i], raw[i])
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	guuid "github.com/google/uuid"
+)
+
+// sortableAlphabet is Crockford's base32 alphabet. Its character ordering
+// matches byte ordering, so encoding a UUID's raw bytes (big-endian) with
+// this alphabet preserves the UUID's natural ordering as a string. This is
+// what makes SortableShortString safe to use as a database primary key for
+// time-ordered (v6/v7) IDs.
+const sortableAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// sortableEncodedLen is the number of characters produced when encoding the
+// 128 bits of a UUID 5 bits at a time.
+const sortableEncodedLen = 26
+
+// NewV6 creates a new time-ordered UUID version 6 ID. Version 6 reorders the
+// timestamp fields of version 1 so that, unlike v1, the bytes sort in
+// timestamp order.
+func NewV6() (ID, error) {
+	u, err := guuid.NewV6()
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to generate v6 uuid: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// NewV7 creates a new time-ordered UUID version 7 ID. Version 7 encodes a
+// Unix millisecond timestamp in its most significant bits followed by random
+// data, making it suitable as a sortable, globally-unique primary key.
+func NewV7() (ID, error) {
+	u, err := guuid.NewV7()
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to generate v7 uuid: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// NewV8 creates a new UUID version 8 ID from three caller-supplied custom
+// fields, as defined by RFC 4122 for experimental and vendor-specific
+// layouts. a occupies the 48-bit time_low/time_mid field, b occupies the
+// 12-bit field adjacent to the version nibble, and c occupies the 62-bit
+// field adjacent to the variant bits. Callers are responsible for choosing a
+// layout that fits their application.
+func NewV8(a uint64, b uint16, c uint64) (ID, error) {
+	var buf [16]byte
+	buf[0] = byte(a >> 40)
+	buf[1] = byte(a >> 32)
+	buf[2] = byte(a >> 24)
+	buf[3] = byte(a >> 16)
+	buf[4] = byte(a >> 8)
+	buf[5] = byte(a)
+
+	buf[6] = byte(b >> 8)
+	buf[7] = byte(b)
+
+	buf[8] = byte(c >> 56)
+	buf[9] = byte(c >> 48)
+	buf[10] = byte(c >> 40)
+	buf[11] = byte(c >> 32)
+	buf[12] = byte(c >> 24)
+	buf[13] = byte(c >> 16)
+	buf[14] = byte(c >> 8)
+	buf[15] = byte(c)
+
+	buf[6] = (buf[6] & 0x0f) | 0x80 // version 8
+	buf[8] = (buf[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	u, err := guuid.FromBytes(buf[:])
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to build v8 uuid: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// Timestamp extracts the timestamp embedded in the ID, if its version
+// carries one. It supports v1, v6, and v7 IDs; other versions return the
+// zero time and false.
+func (id ID) Timestamp() (time.Time, bool) {
+	switch id.UUID.Version() {
+	case 1, 6:
+		sec, nsec := id.UUID.Time().UnixTime()
+		return time.Unix(sec, nsec), true
+	case 7:
+		ms := int64(id.UUID[0])<<40 | int64(id.UUID[1])<<32 | int64(id.UUID[2])<<24 |
+			int64(id.UUID[3])<<16 | int64(id.UUID[4])<<8 | int64(id.UUID[5])
+		return time.UnixMilli(ms), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// SortableShortString returns a compact string encoding of the ID using
+// Crockford base32, a monotonic alphabet. For v6/v7 IDs, the result sorts
+// lexicographically in the same order as the IDs themselves, making it
+// usable as a sortable primary key while remaining short enough for JSON.
+func (id ID) SortableShortString() string {
+	return encodeCrockford32(id.UUID[:])
+}
+
+// ParseSortable parses a string produced by SortableShortString back into an
+// ID.
+func ParseSortable(s string) (ID, error) {
+	b, err := decodeCrockford32(s)
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to decode sortable shortuuid: %w", err)
+	}
+	u, err := guuid.FromBytes(b)
+	if err != nil {
+		return ID{}, fmt.Errorf("failed to build uuid from sortable bytes: %w", err)
+	}
+	return ID{UUID: u}, nil
+}
+
+// encodeCrockford32 encodes a 16-byte UUID as a 26-character Crockford
+// base32 string. 128 bits split into 5-bit groups leaves a final group of
+// only 3 data bits, which is left-shifted by 2 to fill out the last
+// character; those 2 low-order bits are therefore always zero in a
+// canonical encoding.
+func encodeCrockford32(data []byte) string {
+	var sb strings.Builder
+	sb.Grow(sortableEncodedLen)
+
+	var acc uint64
+	var bits uint
+	for _, b := range data {
+		acc = acc<<8 | uint64(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			sb.WriteByte(sortableAlphabet[(acc>>bits)&0x1f])
+		}
+	}
+	if bits > 0 {
+		sb.WriteByte(sortableAlphabet[(acc<<(5-bits))&0x1f])
+	}
+	return sb.String()
+}
+
+// decodeCrockford32 decodes a string produced by encodeCrockford32 back into
+// 16 raw bytes.
+func decodeCrockford32(s string) ([]byte, error) {
+	if len(s) != sortableEncodedLen {
+		return nil, fmt.Errorf("invalid sortable shortuuid length: %d", len(s))
+	}
+
+	out := make([]byte, 0, 16)
+	var acc uint64
+	var bits uint
+	for i := 0; i < len(s); i++ {
+		idx := strings.IndexByte(sortableAlphabet, s[i])
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid sortable shortuuid character: %q", s[i])
+		}
+		acc = acc<<5 | uint64(idx)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			out = append(out, byte(acc>>bits))
+		}
+	}
+	if len(out) != 16 {
+		return nil, fmt.Errorf("invalid sortable shortuuid: decoded %d bytes, want 16", len(out))
+	}
+	// The remaining unconsumed bits are the padding added by
+	// encodeCrockford32's final left shift. A canonical encoding always has
+	// them zero; reject anything else so decode is a true inverse of encode.
+	if bits > 0 && acc&((1<<bits)-1) != 0 {
+		return nil, fmt.Errorf("invalid sortable shortuuid: non-zero padding bits")
+	}
+	return out, nil
+}
@@ -0,0 +1,115 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewV6(t *testing.T) {
+	id, err := NewV6()
+	if err != nil {
+		t.Fatalf("NewV6 failed: %v", err)
+	}
+	if id.UUID.Version() != 6 {
+		t.Errorf("expected version 6, got %d", id.UUID.Version())
+	}
+}
+
+func TestNewV7(t *testing.T) {
+	id, err := NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 failed: %v", err)
+	}
+	if id.UUID.Version() != 7 {
+		t.Errorf("expected version 7, got %d", id.UUID.Version())
+	}
+}
+
+func TestNewV8(t *testing.T) {
+	id, err := NewV8(1, 2, 3)
+	if err != nil {
+		t.Fatalf("NewV8 failed: %v", err)
+	}
+	if id.UUID.Version() != 8 {
+		t.Errorf("expected version 8, got %d", id.UUID.Version())
+	}
+}
+
+func TestTimestampV7(t *testing.T) {
+	before := time.Now()
+	id, err := NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 failed: %v", err)
+	}
+	after := time.Now()
+
+	ts, ok := id.Timestamp()
+	if !ok {
+		t.Fatal("expected v7 ID to carry a timestamp")
+	}
+	if ts.Before(before.Add(-time.Millisecond)) || ts.After(after.Add(time.Millisecond)) {
+		t.Errorf("timestamp %v out of expected range [%v, %v]", ts, before, after)
+	}
+}
+
+func TestTimestampUnsupportedVersion(t *testing.T) {
+	id := New()
+	if _, ok := id.Timestamp(); ok {
+		t.Error("v4 ID should not carry a timestamp")
+	}
+}
+
+func TestSortableShortStringOrdering(t *testing.T) {
+	first, err := NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 failed: %v", err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	second, err := NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 failed: %v", err)
+	}
+
+	if !(first.SortableShortString() < second.SortableShortString()) {
+		t.Errorf("expected %s < %s", first.SortableShortString(), second.SortableShortString())
+	}
+}
+
+func TestSortableRoundTrip(t *testing.T) {
+	id, err := NewV7()
+	if err != nil {
+		t.Fatalf("NewV7 failed: %v", err)
+	}
+
+	s := id.SortableShortString()
+	parsed, err := ParseSortable(s)
+	if err != nil {
+		t.Fatalf("ParseSortable failed: %v", err)
+	}
+	if !id.Equal(parsed) {
+		t.Error("ParseSortable should recover original ID")
+	}
+}
+
+func TestParseSortableInvalid(t *testing.T) {
+	if _, err := ParseSortable("too-short"); err == nil {
+		t.Error("expected error for invalid length")
+	}
+	if _, err := ParseSortable("!!!!!!!!!!!!!!!!!!!!!!!!!!"); err == nil {
+		t.Error("expected error for invalid characters")
+	}
+}
+
+func TestParseSortableRejectsNonCanonicalPadding(t *testing.T) {
+	var zero ID
+	canonical := zero.SortableShortString()
+
+	// Flip the last character to one whose low 2 padding bits are non-zero.
+	// It decodes to the same 16 bytes as canonical were padding ignored, so
+	// this only fails if decodeCrockford32 actually checks the pad bits.
+	nonCanonical := canonical[:len(canonical)-1] + "1"
+
+	if _, err := ParseSortable(nonCanonical); err == nil {
+		t.Error("expected error for non-canonical padding bits")
+	}
+}
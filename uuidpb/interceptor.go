@@ -0,0 +1,42 @@
+package uuidpb
+
+import (
+	"context"
+
+	"github.com/freddierice/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDMetadataKey is the outgoing/incoming metadata key under which
+// WithRequestID stamps a request ID.
+const requestIDMetadataKey = "x-request-id"
+
+// WithRequestID is a gRPC unary client interceptor that stamps a new ID,
+// encoded as a shortuuid, into the outgoing request's metadata under
+// x-request-id. Pair it with RequestIDFromIncomingContext on the server side
+// to propagate a single request ID across an RPC boundary.
+func WithRequestID(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	id := uuid.New()
+	ctx = metadata.AppendToOutgoingContext(ctx, requestIDMetadataKey, id.ShortString())
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// RequestIDFromIncomingContext extracts the request ID stamped by
+// WithRequestID from an incoming gRPC context, returning the zero ID and
+// false if none is present or it cannot be parsed.
+func RequestIDFromIncomingContext(ctx context.Context) (uuid.ID, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return uuid.ID{}, false
+	}
+	values := md.Get(requestIDMetadataKey)
+	if len(values) == 0 {
+		return uuid.ID{}, false
+	}
+	id, err := uuid.Parse(values[0])
+	if err != nil {
+		return uuid.ID{}, false
+	}
+	return id, true
+}
@@ -0,0 +1,82 @@
+// Package uuidpb makes uuid.ID usable as a protobuf field, either via
+// gogo-protobuf's customtype mechanism (ID) or by converting to and from
+// well-known wrapper types (ToProto/FromProto).
+package uuidpb
+
+import (
+	"fmt"
+
+	"github.com/freddierice/uuid"
+	guuid "github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ID wraps uuid.ID with the Marshal/Unmarshal/MarshalTo/Size methods
+// gogo-protobuf's customtype option requires, so it can be used directly as
+// a generated message field's type. The wire representation is the raw
+// 16 bytes of the UUID.
+type ID struct {
+	uuid.ID
+}
+
+// FromID wraps an existing uuid.ID for use as a protobuf customtype field.
+func FromID(id uuid.ID) ID {
+	return ID{ID: id}
+}
+
+// Size returns the wire size of the ID, always 16.
+func (id ID) Size() int {
+	return 16
+}
+
+// Marshal returns the raw 16-byte wire representation of the ID.
+func (id ID) Marshal() ([]byte, error) {
+	buf := make([]byte, 16)
+	if _, err := id.MarshalTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// MarshalTo writes the raw 16-byte wire representation of the ID into data,
+// which must be at least 16 bytes long.
+func (id ID) MarshalTo(data []byte) (int, error) {
+	raw := id.UUID
+	if len(data) < 16 {
+		return 0, fmt.Errorf("uuidpb: buffer too small: got %d bytes, need 16", len(data))
+	}
+	return copy(data, raw[:]), nil
+}
+
+// Unmarshal decodes the raw 16-byte wire representation of the ID from data.
+func (id *ID) Unmarshal(data []byte) error {
+	if len(data) != 16 {
+		return fmt.Errorf("uuidpb: invalid wire length: got %d bytes, want 16", len(data))
+	}
+	u, err := guuid.FromBytes(data)
+	if err != nil {
+		return fmt.Errorf("uuidpb: failed to unmarshal ID: %w", err)
+	}
+	id.ID = uuid.FromUUID(u)
+	return nil
+}
+
+// ToProto converts an ID to its shortuuid string, wrapped in a
+// google.protobuf.StringValue for use in hand-written or non-gogo proto
+// messages.
+func ToProto(id uuid.ID) *wrapperspb.StringValue {
+	return wrapperspb.String(id.ShortString())
+}
+
+// FromProto converts a google.protobuf.StringValue produced by ToProto back
+// into an ID. A nil input yields the zero ID.
+func FromProto(s *wrapperspb.StringValue) (uuid.ID, error) {
+	if s == nil {
+		return uuid.ID{}, nil
+	}
+	id, err := uuid.Parse(s.GetValue())
+	if err != nil {
+		return uuid.ID{}, fmt.Errorf("uuidpb: failed to parse ID from proto: %w", err)
+	}
+	return id, nil
+}
@@ -0,0 +1,57 @@
+package uuidpb
+
+import (
+	"testing"
+
+	"github.com/freddierice/uuid"
+)
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	original := FromID(uuid.New())
+
+	data, err := original.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if len(data) != original.Size() {
+		t.Fatalf("expected %d bytes, got %d", original.Size(), len(data))
+	}
+
+	var decoded ID
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if !decoded.ID.Equal(original.ID) {
+		t.Error("Unmarshal should recover the original ID")
+	}
+}
+
+func TestUnmarshalInvalidLength(t *testing.T) {
+	var id ID
+	if err := id.Unmarshal([]byte{1, 2, 3}); err == nil {
+		t.Error("expected error for invalid wire length")
+	}
+}
+
+func TestToProtoFromProtoRoundTrip(t *testing.T) {
+	original := uuid.New()
+
+	proto := ToProto(original)
+	decoded, err := FromProto(proto)
+	if err != nil {
+		t.Fatalf("FromProto failed: %v", err)
+	}
+	if !decoded.Equal(original) {
+		t.Error("FromProto should recover the original ID")
+	}
+}
+
+func TestFromProtoNil(t *testing.T) {
+	decoded, err := FromProto(nil)
+	if err != nil {
+		t.Fatalf("FromProto(nil) failed: %v", err)
+	}
+	if !decoded.IsZero() {
+		t.Error("FromProto(nil) should return the zero ID")
+	}
+}